@@ -0,0 +1,76 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+
+	jujuerrors "github.com/juju/errors"
+)
+
+// attrError attaches structured slog.Attr key/value pairs to a wrapped
+// error without altering its message. Annotation strings ("more context")
+// lose fidelity once logged; attributes let downstream JSON logging pick up
+// the detail without regex-parsing Error() output.
+type attrError struct {
+	error
+	attrs []slog.Attr
+}
+
+// Unwrap exposes the wrapped error so errors.Is/As keep working through it.
+func (a *attrError) Unwrap() error { return a.error }
+
+// withAttrs attaches attrs to err. If err's chain already carries an
+// attrError, the new one carries a copy of its attrs plus attrs, so Attrs
+// (which returns the outermost match) still reports the full set; the
+// existing attrError is left untouched rather than mutated, since err may be
+// a value shared with another *Errs or goroutine.
+func withAttrs(err error, attrs ...slog.Attr) error {
+	var ae *attrError
+	if errors.As(err, &ae) {
+		merged := make([]slog.Attr, len(ae.attrs), len(ae.attrs)+len(attrs))
+		copy(merged, ae.attrs)
+		return &attrError{error: err, attrs: append(merged, attrs...)}
+	}
+	return &attrError{error: err, attrs: attrs}
+}
+
+// AppendAttrs appends err, annotated with attrs, if err is non-nil.
+func (e *Errs) AppendAttrs(err error, attrs ...slog.Attr) bool {
+	if err == nil {
+		return false
+	}
+	e.append(withAttrs(jujuerrors.Trace(err), attrs...))
+	return true
+}
+
+// NewErrAttrs formats a new error, in the style of jujuerrors.Errorf,
+// attaches attrs to it, and appends it.
+func (e *Errs) NewErrAttrs(format string, attrs []slog.Attr, args ...any) {
+	e.append(withAttrs(jujuerrors.Errorf(format, args...), attrs...))
+}
+
+// Attrs walks err's chain and returns the attributes attached to it, in
+// attachment order, or nil if none were attached.
+func Attrs(err error) []slog.Attr {
+	var ae *attrError
+	if !errors.As(err, &ae) {
+		return nil
+	}
+	return ae.attrs
+}
+
+// LogValue renders the whole bulk error as a slog.Value, one group per
+// collected error keyed by its index, so an *Errs can be logged directly
+// with slog.Any("err", errs) and still surface each error's attributes.
+func (e *Errs) LogValue() slog.Value {
+	errs := e.snapshot()
+	groups := make([]slog.Attr, len(errs))
+	for i, err := range errs {
+		attrs := append([]slog.Attr{slog.String("msg", err.Error())}, Attrs(err)...)
+		groups[i] = slog.Attr{Key: strconv.Itoa(i), Value: slog.GroupValue(attrs...)}
+	}
+	return slog.GroupValue(groups...)
+}
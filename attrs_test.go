@@ -0,0 +1,76 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+)
+
+func TestAppendAttrsAndAttrs(t *testing.T) {
+	e := NewErr()
+	if e.AppendAttrs(nil, slog.String("k", "v")) {
+		t.Error("AppendAttrs returned true for a nil error")
+	}
+	e.AppendAttrs(errors.New("boom"), slog.String("user_id", "42"), slog.Int("retry", 3))
+
+	got := Attrs(e.snapshot()[0])
+	if len(got) != 2 {
+		t.Fatalf("Attrs returned %d attrs, want 2", len(got))
+	}
+	if got[0].Key != "user_id" || got[0].Value.String() != "42" {
+		t.Errorf("Attrs[0] = %v, want user_id=42", got[0])
+	}
+	if got[1].Key != "retry" || got[1].Value.Int64() != 3 {
+		t.Errorf("Attrs[1] = %v, want retry=3", got[1])
+	}
+}
+
+func TestNewErrAttrs(t *testing.T) {
+	e := NewErr()
+	e.NewErrAttrs("field %q is required", []slog.Attr{slog.String("field", "name")}, "name")
+
+	err := e.snapshot()[0]
+	if got, want := err.Error(), `field "name" is required`; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if got := Attrs(err); len(got) != 1 || got[0].Key != "field" {
+		t.Errorf("Attrs = %v, want a single field attr", got)
+	}
+}
+
+func TestAttrsNilForUntaggedError(t *testing.T) {
+	if got := Attrs(errors.New("plain")); got != nil {
+		t.Errorf("Attrs on an untagged error = %v, want nil", got)
+	}
+}
+
+func TestWithAttrsDoesNotMutateSharedWrapper(t *testing.T) {
+	tagged := withAttrs(errors.New("shared"), slog.String("a", "1"))
+
+	e1 := NewErr()
+	e1.AppendAttrs(tagged, slog.String("b", "2"))
+
+	if got := Attrs(tagged); len(got) != 1 || got[0].Key != "a" {
+		t.Errorf("original tagged error's attrs changed to %v, want a single \"a\" attr", got)
+	}
+}
+
+func TestErrsLogValue(t *testing.T) {
+	e := NewErr()
+	e.AppendAttrs(errors.New("boom"), slog.String("user_id", "42"))
+
+	v := e.LogValue()
+	if v.Kind() != slog.KindGroup {
+		t.Fatalf("LogValue().Kind() = %v, want Group", v.Kind())
+	}
+	group := v.Group()
+	if len(group) != 1 {
+		t.Fatalf("LogValue() has %d groups, want 1", len(group))
+	}
+	entry := group[0].Value.Group()
+	if entry[0].Key != "msg" || entry[0].Value.String() != "boom" {
+		t.Errorf("first attr = %v, want msg=boom", entry[0])
+	}
+}
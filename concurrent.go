@@ -0,0 +1,93 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import "sync"
+
+// storage abstracts the collection Errs accumulates errors into, so a
+// concurrent-safe implementation can be swapped in without changing any of
+// the Append* call sites.
+type storage interface {
+	append(err error)
+	snapshot() []error
+}
+
+// unsyncStorage is the default, single-goroutine storage backing Errs.
+type unsyncStorage struct {
+	errs []error
+}
+
+func (s *unsyncStorage) append(err error) {
+	s.errs = append(s.errs, err)
+}
+
+func (s *unsyncStorage) snapshot() []error {
+	return s.errs
+}
+
+// syncStorage is a mutex-guarded storage backing Errs values created with
+// NewErrConcurrent.
+type syncStorage struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (s *syncStorage) append(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, err)
+}
+
+func (s *syncStorage) snapshot() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]error, len(s.errs))
+	copy(out, s.errs)
+	return out
+}
+
+// append stores err through e's storage, unsync by default or mutex-guarded
+// for Errs values created with NewErrConcurrent.
+func (e *Errs) append(err error) {
+	e.store.append(err)
+}
+
+// snapshot returns the errors collected so far.
+func (e *Errs) snapshot() []error {
+	return e.store.snapshot()
+}
+
+// NewErrConcurrent returns a new, empty *Errs whose Append, AppendIfX and
+// NewErrWithCause are safe to call from multiple goroutines, for use in
+// worker pools that would otherwise hand-roll a sync.Mutex plus []error.
+func NewErrConcurrent() *Errs {
+	return &Errs{store: &syncStorage{}}
+}
+
+// Go launches fn in a new goroutine and appends any error it returns into e.
+// Goroutines launched this way never race with each other appending into e,
+// regardless of which storage e was created with, since Go serializes them
+// through its own mutex; it does not make e safe to Append into concurrently
+// from outside Go as well unless e was built with NewErrConcurrent. Use
+// e.Wait to block until every goroutine launched this way has finished.
+func (e *Errs) Go(fn func() error) {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		err := fn()
+		if err == nil {
+			return
+		}
+		e.goMu.Lock()
+		defer e.goMu.Unlock()
+		e.Append(err)
+	}()
+}
+
+// Wait blocks until every goroutine launched with Go has finished, then
+// returns e.ToError(), mirroring errgroup.Group.Wait but collecting every
+// error instead of just the first.
+func (e *Errs) Wait() error {
+	e.wg.Wait()
+	return e.ToError()
+}
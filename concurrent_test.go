@@ -0,0 +1,52 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"testing"
+)
+
+// Run with -race to confirm Go's appends don't race, regardless of which
+// storage the *Errs was created with.
+func TestErrsGoWaitCollectsEveryError(t *testing.T) {
+	const n = 50
+
+	e := NewErr()
+	for i := 0; i < n; i++ {
+		i := i
+		e.Go(func() error {
+			if i%2 == 0 {
+				return nil
+			}
+			return errors.New("failed")
+		})
+	}
+
+	if err := e.Wait(); err == nil {
+		t.Fatal("Wait returned nil, want the collected errors")
+	}
+	if got, want := len(e.snapshot()), n/2; got != want {
+		t.Errorf("collected %d errors, want %d", got, want)
+	}
+}
+
+func TestNewErrConcurrentAppend(t *testing.T) {
+	const n = 50
+
+	e := NewErrConcurrent()
+	done := make(chan struct{})
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			e.Append(errors.New("failed"))
+			done <- struct{}{}
+		}(i)
+	}
+	for i := 0; i < n; i++ {
+		<-done
+	}
+
+	if got := len(e.snapshot()); got != n {
+		t.Errorf("collected %d errors, want %d", got, n)
+	}
+}
@@ -129,5 +129,75 @@ for the situation.
 This returns an error where the complete error stack is still available, and
 'errors.Cause()' will return the 'NotFound' error.
 
+Errs also implements Go's multi-error 'Unwrap() []error', so the standard
+library's 'errors.Is' and 'errors.As' walk every collected error, not just
+the first:
+
+  errs := bulkerrs.NewErr()
+  errs.Append(checkFunc1())
+  errs.Append(checkFunc2())
+  if errors.Is(errs.ToError(), ErrNotFound) {
+    // one of the collected errors is, or wraps, ErrNotFound
+  }
+
+'errs.Has' and 'errs.Filter' are shorthands built on top of that: 'Has'
+reports whether any collected error matches a target, and 'Filter' returns a
+new 'Errs' containing only the ones that do.
+
+For cleanup code that collects errors from a defer statement, 'AppendInvoke'
+and the package-level 'AppendInto' save the usual nil-check:
+
+  defer errs.AppendInvoke(f.Close)
+
+  var errs *bulkerrs.Errs
+  defer func() { bulkerrs.AppendInto(&errs, closeThing()) }()
+
+'AppendInto' takes the error directly rather than a func, so when closeThing
+itself needs to be called at defer time, wrap the call in a closure as shown
+above. A resource that only exposes an io.Closer can be adapted with
+'bulkerrs.Close': 'errs.AppendInvoke(bulkerrs.Close(c).Invoke)'.
+
+Collected errors can also be classified with a 'Kind', independent of their
+message, so callers can branch on the kind instead of matching substrings of
+Error():
+
+  errs := bulkerrs.NewErr()
+  errs.AppendKind(bulkerrs.KindNotFound, checkFunc1())
+  if errs.HasKind(bulkerrs.KindNotFound) {
+    // ...
+  }
+
+The package-level 'bulkerrs.Kinds' returns the union of every kind attached
+anywhere in an error tree, so it also works on the multi-error 'ToError()'
+returns.
+
+Similarly, 'AppendAttrs' attaches structured 'log/slog' attributes to a
+collected error instead of folding more context into its message:
+
+  errs.AppendAttrs(checkFunc1(), slog.String("user_id", id), slog.Int("retry", n))
+
+'Errs' implements 'slog.LogValuer' through 'LogValue', so the whole batch can
+be logged directly with 'slog.Any("err", errs)'.
+
+How 'ToError()' renders is controlled by a 'Formatter'. 'errs.SetFormatter'
+picks one per 'Errs'; 'bulkerrs.DefaultFormatter' is the package-wide
+default. The built-ins are 'OneLineFormatter' (the default, one line joined
+with "; "), 'StackFormatter' (the juju/errors annotation stack for each
+collected error) and 'JSONFormatter'. 'MarshalJSON' renders the same JSON
+shape, so a '*Errs' can be returned directly from an HTTP handler as the body
+of an API error response.
+
+Finally, 'bulkerrs.NewErrConcurrent' returns an 'Errs' whose Append methods
+are safe to call from multiple goroutines, and 'errs.Go' launches a
+goroutine that appends any error it returns, mirroring 'errgroup.Group.Go'
+but collecting every error instead of just the first:
+
+  errs := bulkerrs.NewErrConcurrent()
+  for _, url := range urls {
+    url := url
+    errs.Go(func() error { return fetch(url) })
+  }
+  return errs.Wait()
+
 */
 package bulkerrs
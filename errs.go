@@ -0,0 +1,132 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"sync"
+
+	jujuerrors "github.com/juju/errors"
+)
+
+// Errs collects a sequence of errors produced while running sequential or
+// conditional checks. The zero value is not usable; construct one with
+// NewErr, NewErrOr or NewErrConcurrent.
+type Errs struct {
+	store     storage
+	formatter Formatter
+	wg        sync.WaitGroup
+	goMu      sync.Mutex
+}
+
+// NewErr returns a new, empty *Errs ready to accumulate errors.
+func NewErr() *Errs {
+	return &Errs{store: &unsyncStorage{}}
+}
+
+// NewErrOr returns a new *Errs, appending err to it if err is non-nil. It
+// saves callers from writing the usual "if err != nil" dance right after
+// constructing the Errs.
+func NewErrOr(err error) *Errs {
+	e := NewErr()
+	e.Append(err)
+	return e
+}
+
+// Append appends err to the inner slice, tracing it with jujuerrors.Trace so
+// the call site is recorded. It reports whether err was non-nil.
+func (e *Errs) Append(err error) bool {
+	if err == nil {
+		return false
+	}
+	e.append(jujuerrors.Trace(err))
+	return true
+}
+
+// NewErrWithCause appends err annotated with context, if err is non-nil. It
+// is the Errs equivalent of jujuerrors.Annotate.
+func (e *Errs) NewErrWithCause(err error, context string) bool {
+	if err == nil {
+		return false
+	}
+	e.append(jujuerrors.Annotate(err, context))
+	return true
+}
+
+// AppendIfX appends wrap(err, msg) when cond is true and the result is
+// non-nil. wrap is typically one of the jujuerrors constructors such as
+// jujuerrors.Annotate or jujuerrors.NewNotValid, letting callers pick the
+// error type while still going through the same bookkeeping as Append.
+func (e *Errs) AppendIfX(cond bool, wrap func(error, string) error, msg string, err error) bool {
+	if !cond {
+		return false
+	}
+	wrapped := wrap(err, msg)
+	if wrapped == nil {
+		return false
+	}
+	e.append(wrapped)
+	return true
+}
+
+// ToError returns nil if no errors have been collected, or an error
+// representing the accumulated errors otherwise. The returned error is the
+// *Errs itself, so callers can type-assert back to it if they need access
+// to the individual errors.
+func (e *Errs) ToError() error {
+	if len(e.snapshot()) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error renders the collected errors with e's Formatter, or DefaultFormatter
+// if SetFormatter was never called, satisfying the error interface.
+func (e *Errs) Error() string {
+	return e.formatterOrDefault().Format(e.snapshot())
+}
+
+// ErrorStack renders the juju/errors stack trace of every collected error,
+// one after another, mirroring jujuerrors.ErrorStack.
+func (e *Errs) ErrorStack() string {
+	return StackFormatter.Format(e.snapshot())
+}
+
+// SetFormatter overrides how e renders through Error(), and so how the error
+// ToError returns renders. MarshalJSON, ErrorStack and LogValue do not use
+// it. Passing nil reverts e to DefaultFormatter.
+func (e *Errs) SetFormatter(f Formatter) {
+	e.formatter = f
+}
+
+func (e *Errs) formatterOrDefault() Formatter {
+	if e.formatter != nil {
+		return e.formatter
+	}
+	return DefaultFormatter
+}
+
+// Unwrap returns the accumulated inner errors, implementing the Go 1.20
+// multi-error interface used by errors.Is and errors.As.
+func (e *Errs) Unwrap() []error {
+	return e.snapshot()
+}
+
+// Has reports whether target matches any of the collected errors, checking
+// each one with errors.Is.
+func (e *Errs) Has(target error) bool {
+	return errors.Is(e, target)
+}
+
+// Filter returns a new *Errs containing only the collected errors that match
+// target according to errors.Is. It returns an empty, non-nil *Errs when
+// nothing matches.
+func (e *Errs) Filter(target error) *Errs {
+	filtered := NewErr()
+	for _, err := range e.snapshot() {
+		if errors.Is(err, target) {
+			filtered.append(err)
+		}
+	}
+	return filtered
+}
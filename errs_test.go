@@ -0,0 +1,77 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"testing"
+)
+
+type notFoundError struct{ name string }
+
+func (e *notFoundError) Error() string { return e.name + " not found" }
+
+func TestErrsUnwrapIsAs(t *testing.T) {
+	sentinel := errors.New("boom")
+	nf := &notFoundError{name: "widget"}
+
+	e := NewErr()
+	e.Append(errors.New("unrelated"))
+	e.Append(sentinel)
+	e.Append(nf)
+
+	err := e.ToError()
+	if err == nil {
+		t.Fatal("ToError returned nil after appending errors")
+	}
+
+	if !errors.Is(err, sentinel) {
+		t.Error("errors.Is did not find the sentinel among the collected errors")
+	}
+
+	var target *notFoundError
+	if !errors.As(err, &target) {
+		t.Fatal("errors.As did not find the notFoundError among the collected errors")
+	}
+	if target.name != "widget" {
+		t.Errorf("errors.As found the wrong error: got name %q", target.name)
+	}
+
+	if errors.Is(err, errors.New("nowhere to be found")) {
+		t.Error("errors.Is matched an error that was never appended")
+	}
+}
+
+func TestErrsHasAndFilter(t *testing.T) {
+	sentinel := errors.New("boom")
+
+	e := NewErr()
+	e.Append(errors.New("unrelated"))
+	e.Append(sentinel)
+
+	if !e.Has(sentinel) {
+		t.Error("Has returned false for an appended sentinel")
+	}
+	if e.Has(errors.New("boom")) {
+		t.Error("Has matched a different error value with the same message")
+	}
+
+	filtered := e.Filter(sentinel)
+	if got := len(filtered.snapshot()); got != 1 {
+		t.Fatalf("Filter returned %d errors, want 1", got)
+	}
+	if !errors.Is(filtered.ToError(), sentinel) {
+		t.Error("Filter result does not match the sentinel anymore")
+	}
+}
+
+func TestErrsToErrorNilWhenEmpty(t *testing.T) {
+	e := NewErr()
+	if err := e.ToError(); err != nil {
+		t.Errorf("ToError on an empty Errs returned %v, want nil", err)
+	}
+	e.Append(nil)
+	if err := e.ToError(); err != nil {
+		t.Errorf("ToError after appending nil returned %v, want nil", err)
+	}
+}
@@ -0,0 +1,138 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	jujuerrors "github.com/juju/errors"
+)
+
+// Formatter renders the errors collected by an Errs into a single string.
+// Use SetFormatter to pick one per Errs, or override DefaultFormatter to
+// change the package-wide default.
+type Formatter interface {
+	Format(errs []error) string
+}
+
+// FormatterFunc adapts a plain function to the Formatter interface.
+type FormatterFunc func(errs []error) string
+
+// Format calls f.
+func (f FormatterFunc) Format(errs []error) string { return f(errs) }
+
+// DefaultFormatter is the Formatter used by Errs values that never called
+// SetFormatter. It starts out as OneLineFormatter.
+var DefaultFormatter Formatter = OneLineFormatter
+
+// OneLineFormatter joins every collected error's message with "; ".
+var OneLineFormatter = FormatterFunc(func(errs []error) string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+})
+
+// StackFormatter renders each collected error's juju/errors annotation
+// stack, one after another.
+var StackFormatter = FormatterFunc(func(errs []error) string {
+	stacks := make([]string, len(errs))
+	for i, err := range errs {
+		stacks[i] = stackOf(err)
+	}
+	return strings.Join(stacks, "\n")
+})
+
+// JSONFormatter renders the collected errors as a JSON array, in the same
+// shape MarshalJSON produces.
+var JSONFormatter = FormatterFunc(func(errs []error) string {
+	b, err := json.Marshal(toJSONErrors(errs))
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+})
+
+// jsonError is the wire shape JSONFormatter and MarshalJSON emit for each
+// collected error.
+type jsonError struct {
+	Msg      string `json:"msg"`
+	Location string `json:"location,omitempty"`
+	Cause    string `json:"cause,omitempty"`
+	Kinds    []Kind `json:"kinds,omitempty"`
+}
+
+// causer is implemented by juju/errors' Err type.
+type causer interface {
+	Cause() error
+}
+
+// chainOf walks err's chain via errors.Unwrap, since wrapper types added by
+// this package (kindError, attrError) sit outside juju/errors' own Cause
+// chain and only expose themselves through Unwrap.
+func chainOf(err error) []error {
+	var chain []error
+	for cur := err; cur != nil; cur = errors.Unwrap(cur) {
+		chain = append(chain, cur)
+	}
+	return chain
+}
+
+// locationOf returns the function:line jujuerrors.Err.Location reports for
+// the first error in err's chain that records one, or "" if none do.
+func locationOf(err error) string {
+	for _, cur := range chainOf(err) {
+		if loc, ok := cur.(jujuerrors.Locationer); ok {
+			if function, line := loc.Location(); function != "" {
+				return fmt.Sprintf("%s:%d", function, line)
+			}
+		}
+	}
+	return ""
+}
+
+// causeOf returns the root cause of err, deferring to jujuerrors.Cause once
+// the chain reaches a juju/errors error so causes recorded via Annotate/Wrap
+// are still resolved fully.
+func causeOf(err error) error {
+	for _, cur := range chainOf(err) {
+		if _, ok := cur.(causer); ok {
+			return jujuerrors.Cause(cur)
+		}
+	}
+	return err
+}
+
+// stackOf renders the juju/errors annotation stack of the first juju/errors
+// error in err's chain, or err's own message if none is found.
+func stackOf(err error) string {
+	for _, cur := range chainOf(err) {
+		if _, ok := cur.(jujuerrors.Locationer); ok {
+			return jujuerrors.ErrorStack(cur)
+		}
+	}
+	return err.Error()
+}
+
+func toJSONErrors(errs []error) []jsonError {
+	out := make([]jsonError, len(errs))
+	for i, err := range errs {
+		je := jsonError{Msg: err.Error(), Kinds: Kinds(err), Location: locationOf(err)}
+		if cause := causeOf(err); cause != nil && cause != err {
+			je.Cause = cause.Error()
+		}
+		out[i] = je
+	}
+	return out
+}
+
+// MarshalJSON renders e as a JSON array of
+// {"msg":...,"location":"function:line","cause":...,"kinds":[...]} objects,
+// so a *Errs can be dropped straight into an API error response.
+func (e *Errs) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toJSONErrors(e.snapshot()))
+}
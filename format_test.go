@@ -0,0 +1,93 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestOneLineFormatter(t *testing.T) {
+	e := NewErr()
+	e.Append(errors.New("first"))
+	e.Append(errors.New("second"))
+
+	if got, want := e.Error(), "first; second"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestMarshalJSONPlainError(t *testing.T) {
+	e := NewErr()
+	e.Append(errors.New("plain"))
+
+	b, err := e.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON returned error: %v", err)
+	}
+
+	var got []jsonError
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshaling MarshalJSON output: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d entries, want 1", len(got))
+	}
+	if got[0].Location == "" {
+		t.Error("location is empty for a jujuerrors.Trace-wrapped error")
+	}
+	if got[0].Cause != "plain" {
+		t.Errorf("cause = %q, want %q", got[0].Cause, "plain")
+	}
+}
+
+func TestMarshalJSONKindTaggedErrorKeepsLocationAndCause(t *testing.T) {
+	e := NewErr()
+	e.AppendKind(KindNotFound, errors.New("kind-tagged"))
+
+	b, err := e.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON returned error: %v", err)
+	}
+
+	var got []jsonError
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshaling MarshalJSON output: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d entries, want 1", len(got))
+	}
+	if len(got[0].Kinds) != 1 || got[0].Kinds[0] != KindNotFound {
+		t.Errorf("kinds = %v, want [%v]", got[0].Kinds, KindNotFound)
+	}
+	if got[0].Location == "" {
+		t.Error("location is empty for a kind-tagged error; AppendKind still traces the underlying error")
+	}
+	if got[0].Cause != "kind-tagged" {
+		t.Errorf("cause = %q, want %q", got[0].Cause, "kind-tagged")
+	}
+}
+
+func TestStackFormatterKindTaggedErrorHasLocation(t *testing.T) {
+	e := NewErr()
+	e.AppendKind(KindTimeout, errors.New("slow"))
+
+	// jujuerrors.Err.Location reports the package-qualified function name
+	// and line where AppendKind called jujuerrors.Trace, not a file path.
+	stack := e.ErrorStack()
+	if !strings.Contains(stack, "AppendKind:") {
+		t.Errorf("ErrorStack() = %q, want it to contain the AppendKind call site", stack)
+	}
+}
+
+func TestSetFormatter(t *testing.T) {
+	e := NewErr()
+	e.Append(errors.New("oops"))
+	e.SetFormatter(JSONFormatter)
+
+	if got := e.Error(); !strings.HasPrefix(got, "[") {
+		t.Errorf("Error() after SetFormatter(JSONFormatter) = %q, want a JSON array", got)
+	}
+}
@@ -0,0 +1,59 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import "io"
+
+// Invoker is implemented by cleanup helpers that adapt a resource into the
+// func() error shape AppendInvoke expects, for callers that don't already
+// have a bare method value handy.
+type Invoker interface {
+	Invoke() error
+}
+
+// closerInvoker adapts an io.Closer to the Invoker interface.
+type closerInvoker struct{ c io.Closer }
+
+// Invoke calls the wrapped Closer's Close method.
+func (w closerInvoker) Invoke() error { return w.c.Close() }
+
+// Close adapts c to an Invoker, so it can be deferred as
+// defer errs.AppendInvoke(bulkerrs.Close(c).Invoke) when a bare Close method
+// value isn't available.
+func Close(c io.Closer) Invoker {
+	return closerInvoker{c}
+}
+
+// AppendInvoke calls fn and appends the error it returns, if any. It is
+// meant to be used directly in a defer statement, e.g.
+// defer errs.AppendInvoke(f.Close), without having to nil-check the result
+// by hand.
+func (e *Errs) AppendInvoke(fn func() error) bool {
+	return e.Append(fn())
+}
+
+// AppendInvokeIfX calls fn and, if cond is true, appends wrap(err, msg) for
+// the error it returns. It mirrors AppendIfX for deferred cleanup, so a
+// deferred Close can still be annotated or reclassified like any other
+// appended error.
+func (e *Errs) AppendInvokeIfX(cond bool, wrap func(error, string) error, msg string, fn func() error) bool {
+	return e.AppendIfX(cond, wrap, msg, fn())
+}
+
+// AppendInto appends err to *into if err is non-nil, allocating a new Errs
+// with NewErr if *into is nil. It lets cleanup code collect errors without
+// requiring an Errs to already exist. Wrap the call in a closure when
+// deferring it, since a bare defer evaluates closeThing() immediately
+// instead of when the surrounding function returns:
+//
+//	var errs *bulkerrs.Errs
+//	defer func() { bulkerrs.AppendInto(&errs, closeThing()) }()
+func AppendInto(into **Errs, err error) bool {
+	if err == nil {
+		return false
+	}
+	if *into == nil {
+		*into = NewErr()
+	}
+	return (*into).Append(err)
+}
@@ -0,0 +1,101 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"testing"
+)
+
+type fakeCloser struct {
+	err    error
+	called int
+}
+
+func (c *fakeCloser) Close() error {
+	c.called++
+	return c.err
+}
+
+func TestAppendInvoke(t *testing.T) {
+	e := NewErr()
+	c := &fakeCloser{}
+	if e.AppendInvoke(c.Close) {
+		t.Error("AppendInvoke returned true for a Close that succeeded")
+	}
+	if c.called != 1 {
+		t.Fatalf("Close was called %d times, want 1", c.called)
+	}
+
+	c.err = errors.New("close failed")
+	if !e.AppendInvoke(c.Close) {
+		t.Error("AppendInvoke returned false for a Close that failed")
+	}
+	if len(e.snapshot()) != 1 {
+		t.Fatalf("collected %d errors, want 1", len(e.snapshot()))
+	}
+}
+
+func TestClose(t *testing.T) {
+	c := &fakeCloser{err: errors.New("close failed")}
+	inv := Close(c)
+
+	e := NewErr()
+	if !e.AppendInvoke(inv.Invoke) {
+		t.Error("AppendInvoke returned false for a failing Invoker")
+	}
+	if c.called != 1 {
+		t.Errorf("Close was called %d times, want 1", c.called)
+	}
+}
+
+func TestAppendInvokeIfX(t *testing.T) {
+	e := NewErr()
+	c := &fakeCloser{err: errors.New("close failed")}
+	wrap := func(err error, msg string) error {
+		if err == nil {
+			return nil
+		}
+		return errors.New(msg + ": " + err.Error())
+	}
+
+	if e.AppendInvokeIfX(false, wrap, "ctx", c.Close) {
+		t.Error("AppendInvokeIfX returned true despite cond being false")
+	}
+	if len(e.snapshot()) != 0 {
+		t.Fatalf("collected %d errors, want 0", len(e.snapshot()))
+	}
+
+	if !e.AppendInvokeIfX(true, wrap, "cleanup", c.Close) {
+		t.Error("AppendInvokeIfX returned false for a failing Close")
+	}
+	if got, want := e.snapshot()[0].Error(), "cleanup: close failed"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAppendInto(t *testing.T) {
+	var errs *Errs
+
+	if AppendInto(&errs, nil) {
+		t.Error("AppendInto returned true for a nil error")
+	}
+	if errs != nil {
+		t.Fatal("AppendInto allocated an Errs for a nil error")
+	}
+
+	if !AppendInto(&errs, errors.New("boom")) {
+		t.Error("AppendInto returned false for a non-nil error")
+	}
+	if errs == nil {
+		t.Fatal("AppendInto did not allocate an Errs for a non-nil error")
+	}
+	if len(errs.snapshot()) != 1 {
+		t.Fatalf("collected %d errors, want 1", len(errs.snapshot()))
+	}
+
+	AppendInto(&errs, errors.New("boom again"))
+	if len(errs.snapshot()) != 2 {
+		t.Fatalf("collected %d errors, want 2", len(errs.snapshot()))
+	}
+}
@@ -0,0 +1,115 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+
+	jujuerrors "github.com/juju/errors"
+)
+
+// Kind classifies an error along an application-defined axis, independent of
+// its message, so callers can branch on HasKind/Kinds instead of matching
+// substrings of Error() output.
+type Kind string
+
+// Registered kinds covering the classifications bulkerrs users reach for
+// most often. Applications are free to define their own Kind values.
+const (
+	KindNotFound   Kind = "not_found"
+	KindTimeout    Kind = "timeout"
+	KindValidation Kind = "validation"
+	KindNetwork    Kind = "network"
+)
+
+// kindError attaches one or more Kind values to a wrapped error without
+// altering its message; Error() and Unwrap() delegate to the wrapped error.
+type kindError struct {
+	error
+	kinds []Kind
+}
+
+// Unwrap exposes the wrapped error so errors.Is/As keep working through it.
+func (k *kindError) Unwrap() error { return k.error }
+
+// withKind attaches kind to err. If err's chain already carries a kindError,
+// the new one carries a copy of its kinds plus kind, so the outermost
+// kindError alone holds the full set; the existing kindError is left
+// untouched rather than mutated, since err may be a value shared with
+// another *Errs or goroutine.
+func withKind(err error, kind Kind) error {
+	var ke *kindError
+	if errors.As(err, &ke) {
+		kinds := make([]Kind, len(ke.kinds), len(ke.kinds)+1)
+		copy(kinds, ke.kinds)
+		return &kindError{error: err, kinds: append(kinds, kind)}
+	}
+	return &kindError{error: err, kinds: []Kind{kind}}
+}
+
+// AppendKind appends err, tagged with kind, if err is non-nil. It replaces
+// the pattern of passing a constructor like errors.NewNotValid to AppendIfX
+// with a data-driven classifier that errors.Is still works against.
+func (e *Errs) AppendKind(kind Kind, err error) bool {
+	if err == nil {
+		return false
+	}
+	e.append(withKind(jujuerrors.Trace(err), kind))
+	return true
+}
+
+// NewErrKind formats a new error, in the style of jujuerrors.Errorf, tags it
+// with kind, and appends it.
+func (e *Errs) NewErrKind(kind Kind, format string, args ...any) {
+	e.append(withKind(jujuerrors.Errorf(format, args...), kind))
+}
+
+// HasKind reports whether any collected error carries kind.
+func (e *Errs) HasKind(kind Kind) bool {
+	for _, err := range e.snapshot() {
+		for _, k := range Kinds(err) {
+			if k == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Kinds walks err's error tree and returns the union of every kind attached
+// anywhere in it, in the order each kind is first encountered, or nil if
+// none were attached. Unlike a plain errors.As(err, &kindError), this also
+// collects kinds from every branch of a multi-error such as the one
+// (*Errs).ToError returns, not just the first one that carries a kind.
+func Kinds(err error) []Kind {
+	seen := make(map[Kind]bool)
+	var kinds []Kind
+	walkKinds(err, func(k Kind) {
+		if !seen[k] {
+			seen[k] = true
+			kinds = append(kinds, k)
+		}
+	})
+	return kinds
+}
+
+// walkKinds visits err and everything it (possibly multiply) unwraps to,
+// calling add for every Kind found along the way.
+func walkKinds(err error, add func(Kind)) {
+	if err == nil {
+		return
+	}
+	if ke, ok := err.(*kindError); ok {
+		for _, k := range ke.kinds {
+			add(k)
+		}
+	}
+	switch x := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, sub := range x.Unwrap() {
+			walkKinds(sub, add)
+		}
+	case interface{ Unwrap() error }:
+		walkKinds(x.Unwrap(), add)
+	}
+}
@@ -0,0 +1,66 @@
+// Licensed under the GPLv3, see LICENCE file for details.
+
+package bulkerrs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAppendKindAndHasKind(t *testing.T) {
+	e := NewErr()
+	if e.AppendKind(KindNotFound, nil) {
+		t.Error("AppendKind returned true for a nil error")
+	}
+	e.AppendKind(KindNotFound, errors.New("missing"))
+
+	if !e.HasKind(KindNotFound) {
+		t.Error("HasKind returned false for a kind that was appended")
+	}
+	if e.HasKind(KindTimeout) {
+		t.Error("HasKind returned true for a kind that was never appended")
+	}
+}
+
+func TestNewErrKind(t *testing.T) {
+	e := NewErr()
+	e.NewErrKind(KindValidation, "field %q is required", "name")
+
+	if !e.HasKind(KindValidation) {
+		t.Error("HasKind returned false after NewErrKind")
+	}
+	if got, want := e.snapshot()[0].Error(), `field "name" is required`; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestKindsUnionAcrossCollectedErrors(t *testing.T) {
+	e := NewErr()
+	e.AppendKind(KindNotFound, errors.New("e1"))
+	e.AppendKind(KindTimeout, errors.New("e2"))
+	e.Append(errors.New("untagged"))
+
+	got := Kinds(e.ToError())
+	if len(got) != 2 || got[0] != KindNotFound || got[1] != KindTimeout {
+		t.Fatalf("Kinds(e.ToError()) = %v, want [%v %v]", got, KindNotFound, KindTimeout)
+	}
+}
+
+func TestKindsNilForUntaggedError(t *testing.T) {
+	if got := Kinds(errors.New("plain")); got != nil {
+		t.Errorf("Kinds on an untagged error = %v, want nil", got)
+	}
+}
+
+func TestWithKindDoesNotMutateSharedWrapper(t *testing.T) {
+	tagged := withKind(errors.New("shared"), KindNetwork)
+
+	e1 := NewErr()
+	e1.AppendKind(KindNotFound, tagged)
+	e2 := NewErr()
+	e2.AppendKind(KindTimeout, tagged)
+
+	if got := Kinds(tagged); len(got) != 1 || got[0] != KindNetwork {
+		t.Errorf("original tagged error's kinds changed to %v, want [%v]", got, KindNetwork)
+	}
+}